@@ -0,0 +1,338 @@
+// Package lru implements a thread-safe, generic priority expiry LRU
+// cache.
+package lru
+
+import (
+	"container/heap"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// core holds Cache's state. It's kept separate from Cache so a running
+// janitor goroutine can reference it without pinning the exported Cache
+// in memory; see newCache.
+type core[K comparable, V any] struct {
+	mu sync.Mutex
+
+	maxItems   int
+	defaultTTL time.Duration
+	lru        bool
+	onEvicted  func(K, V, EvictReason)
+
+	table     map[K]*Item[K, V]
+	priorityQ PriorityQueue[K, V]
+	expiryQ   ExpiryQueue[K, V]
+
+	// currentGeneration and logicalLen back Invalidate/Purge: rather
+	// than walking the table and both heaps, invalidation just bumps
+	// currentGeneration and resets logicalLen. Stale entries (whose
+	// gen no longer matches) are cleaned up lazily as Get and
+	// evictItems encounter them.
+	currentGeneration int64
+	logicalLen        int
+
+	expiryJitter float64
+
+	purgeInterval time.Duration
+	closeOnce     sync.Once
+	done          chan struct{}
+	stopped       chan struct{}
+
+	sfMu  sync.Mutex
+	calls map[K]*call[V]
+
+	statHits     atomic.Uint64
+	statMisses   atomic.Uint64
+	statAdded    atomic.Uint64
+	statExpired  atomic.Uint64
+	statCapacity atomic.Uint64
+	statPriority atomic.Uint64
+}
+
+// NewCache creates a new priority expiry LRU cache configured by opts.
+func NewCache[K comparable, V any](opts ...Option[K, V]) *Cache[K, V] {
+	c := &core[K, V]{table: make(map[K]*Item[K, V])}
+	for _, opt := range opts {
+		opt(c)
+	}
+	heap.Init(&c.priorityQ)
+	heap.Init(&c.expiryQ)
+	return newCache(c)
+}
+
+// Keys returns the keys in the cache, sorted by their string
+// representation.
+func (c *core[K, V]) Keys() []K {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	keys := make([]K, 0, c.logicalLen)
+	for k, item := range c.table {
+		if item.gen != c.currentGeneration {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j])
+	})
+	return keys
+}
+
+// Len returns the number of items currently in the cache.
+func (c *core[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.logicalLen
+}
+
+// Get returns the value for the key and reports whether it was found.
+//
+// If the item is already expired, it's evicted and the eviction
+// callback, if any, fires with ReasonExpired.
+func (c *core[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	item, ok := c.table[key]
+	if !ok {
+		c.statMisses.Add(1)
+		var zero V
+		return zero, false
+	}
+	if item.gen != c.currentGeneration {
+		// Stale entry left behind by a prior Invalidate/Purge.
+		c.removeItem(item)
+		c.statMisses.Add(1)
+		var zero V
+		return zero, false
+	}
+	if time.Now().Before(item.expire) {
+		if c.lru {
+			item.access = time.Now()
+			// Priority queue could be affected by the
+			// updated access time for the LRU operation.
+			heap.Fix(&c.priorityQ, item.priorityIndex)
+		}
+		c.statHits.Add(1)
+		return item.value, true
+	}
+	c.removeItem(item)
+	c.fireEvicted(item, ReasonExpired)
+	c.statMisses.Add(1)
+	var zero V
+	return zero, false
+}
+
+// Peek returns the value for key without updating its LRU access time
+// or evicting it if expired.
+func (c *core[K, V]) Peek(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	item, ok := c.table[key]
+	if !ok || item.gen != c.currentGeneration || !time.Now().Before(item.expire) {
+		var zero V
+		return zero, false
+	}
+	return item.value, true
+}
+
+// Set sets the new value for the key, with priority and ttl. A zero ttl
+// uses the cache's default TTL, set via WithDefaultTTL.
+func (c *core[K, V]) Set(key K, value V, priority int, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ttl == 0 {
+		ttl = c.defaultTTL
+	}
+	if c.expiryJitter > 0 {
+		ttl = jitter(ttl, c.expiryJitter)
+	}
+	accessTime := time.Now()
+	expireTime := accessTime.Add(ttl)
+
+	item := c.table[key]
+	if item != nil && item.gen != c.currentGeneration {
+		// Stale entry left behind by a prior Invalidate/Purge;
+		// treat key as unseen.
+		c.removeItem(item)
+		item = nil
+	}
+	if item != nil {
+		item.value = value
+		item.priority = priority
+		item.access = accessTime
+		heap.Fix(&c.priorityQ, item.priorityIndex)
+		if item.expire != expireTime {
+			item.expire = expireTime
+			heap.Fix(&c.expiryQ, item.expiryIndex)
+		}
+	} else {
+		item = &Item[K, V]{
+			key:      key,
+			value:    value,
+			priority: priority,
+			expire:   expireTime,
+			access:   accessTime,
+			gen:      c.currentGeneration,
+		}
+		c.table[key] = item
+		heap.Push(&c.priorityQ, item)
+		heap.Push(&c.expiryQ, item)
+		c.logicalLen++
+		c.statAdded.Add(1)
+	}
+	c.evictItems()
+}
+
+// Remove deletes key from the cache, if present. It does not invoke the
+// eviction callback, since the removal is explicit, not evicted.
+func (c *core[K, V]) Remove(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	item, ok := c.table[key]
+	if !ok {
+		return
+	}
+	c.removeItem(item)
+}
+
+// Invalidate drops every item in the cache in O(1) by advancing the
+// cache's generation, rather than walking the table and both heaps.
+// Entries from prior generations are cleaned up lazily, as Get and
+// evictItems encounter them. It does not invoke the eviction callback.
+func (c *core[K, V]) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.currentGeneration++
+	c.logicalLen = 0
+}
+
+// Purge removes all items from the cache. It's an alias for Invalidate,
+// kept for the pre-existing API.
+func (c *core[K, V]) Purge() {
+	c.Invalidate()
+}
+
+// SetMaxItems updates the maximum number of items held by the cache,
+// evicting immediately if the new limit is lower than the current size.
+func (c *core[K, V]) SetMaxItems(maxItems int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.maxItems = maxItems
+	c.evictItems()
+}
+
+// removeItem deletes item from the table and both heaps. logicalLen is
+// only adjusted when item belongs to the current generation: stale
+// entries left behind by Invalidate were already excluded from it.
+func (c *core[K, V]) removeItem(item *Item[K, V]) {
+	delete(c.table, item.key)
+	if item.priorityIndex >= 0 {
+		heap.Remove(&c.priorityQ, item.priorityIndex)
+	}
+	if item.expiryIndex >= 0 {
+		heap.Remove(&c.expiryQ, item.expiryIndex)
+	}
+	if item.gen == c.currentGeneration {
+		c.logicalLen--
+	}
+}
+
+func (c *core[K, V]) fireEvicted(item *Item[K, V], reason EvictReason) {
+	switch reason {
+	case ReasonExpired:
+		c.statExpired.Add(1)
+	case ReasonCapacity:
+		c.statCapacity.Add(1)
+	case ReasonPriority:
+		c.statPriority.Add(1)
+	}
+	if c.onEvicted != nil {
+		c.onEvicted(item.key, item.value, reason)
+	}
+}
+
+// InvalidateFn removes every item for which fn returns true. Unlike
+// Invalidate, this walks the table, since only the matching items are
+// removed, not all of them. It does not invoke the eviction callback.
+func (c *core[K, V]) InvalidateFn(fn func(key K) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k, item := range c.table {
+		if item.gen != c.currentGeneration || !fn(k) {
+			continue
+		}
+		c.removeItem(item)
+	}
+}
+
+// evictItems evicts items from the cache to make room for new ones.
+func (c *core[K, V]) evictItems() {
+	// maxItems <= 0, including the unset zero value, means unbounded:
+	// a Cache built without WithMaxItems must not evict everything.
+	if c.maxItems <= 0 || c.logicalLen <= c.maxItems {
+		return
+	}
+
+	// Evicts expired items first, if any.
+	for c.expiryQ.Len() > 0 {
+		// Peek the candidate eviction item.
+		item := c.expiryQ[0]
+		if item.gen != c.currentGeneration {
+			// Stale entry left behind by Invalidate; drop it
+			// without counting it as an eviction.
+			c.removeItem(item)
+			continue
+		}
+		if item.expire.After(time.Now()) {
+			// No more expired items, try the priority based
+			// eviction next.
+			break
+		}
+		c.removeItem(item)
+		c.fireEvicted(item, ReasonExpired)
+		if c.logicalLen <= c.maxItems {
+			// done.
+			return
+		}
+	}
+
+	// Evicts items based on the priority.
+	//
+	// Evicts LRU, Least Recent Updated, items in case of the same
+	// priority.
+	for c.priorityQ.Len() > 0 {
+		item := c.priorityQ[0]
+		if item.gen != c.currentGeneration {
+			c.removeItem(item)
+			continue
+		}
+		c.removeItem(item)
+		// The heap property guarantees the new top, if any, is the
+		// priority-wise minimum among what's left: if it shares
+		// item's priority, item was only evicted on the LRU
+		// tie-break, not for uniquely holding the lowest priority.
+		reason := ReasonPriority
+		if c.priorityQ.Len() > 0 && c.priorityQ[0].priority == item.priority {
+			reason = ReasonCapacity
+		}
+		c.fireEvicted(item, reason)
+		if c.logicalLen <= c.maxItems {
+			// done.
+			return
+		}
+	}
+}
+
+// jitter scales d by a uniform random factor in [1-fraction, 1+fraction],
+// clamping fraction to [0, 1] so the result is never negative.
+func jitter(d time.Duration, fraction float64) time.Duration {
+	if fraction > 1 {
+		fraction = 1
+	}
+	factor := 1 + (rand.Float64()*2-1)*fraction
+	return time.Duration(float64(d) * factor)
+}
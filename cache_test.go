@@ -1,4 +1,4 @@
-package main
+package lru
 
 import (
 	"sort"
@@ -7,12 +7,12 @@ import (
 )
 
 func TestLRU(t *testing.T) {
-	c := NewCache(5)
-	c.Set("A", 1, 5, 100)
-	c.Set("B", 2, 15, 3)
-	c.Set("C", 3, 5, 10)
-	c.Set("D", 4, 1, 15)
-	c.Set("E", 5, 5, 150)
+	c := NewCache[string, int](WithMaxItems[string, int](5), WithLRU[string, int](true))
+	c.Set("A", 1, 5, 100*time.Second)
+	c.Set("B", 2, 15, 3*time.Second)
+	c.Set("C", 3, 5, 10*time.Second)
+	c.Set("D", 4, 1, 15*time.Second)
+	c.Set("E", 5, 5, 150*time.Second)
 	c.Get("A")
 
 	c.SetMaxItems(5)
@@ -25,7 +25,7 @@ func TestLRU(t *testing.T) {
 	// Make "B" the same priority with othse items.
 	// We'll pick the "E" as the eviction, as it was
 	// not accessed before.
-	c.Set("B", 2, 5, 3)
+	c.Set("B", 2, 5, 3*time.Second)
 	c.SetMaxItems(3)
 	testSlice(t, c.Keys(), []string{"A", "B", "C"})
 
@@ -38,13 +38,13 @@ func TestLRU(t *testing.T) {
 	testSlice(t, c.Keys(), []string{"A"})
 }
 
-func TestMain(t *testing.T) {
-	c := NewCache(5)
-	c.Set("A", 1, 5, 100)
-	c.Set("B", 2, 15, 3)
-	c.Set("C", 3, 5, 10)
-	c.Set("D", 4, 1, 15)
-	c.Set("E", 5, 5, 150)
+func TestExpiry(t *testing.T) {
+	c := NewCache[string, int](WithMaxItems[string, int](5), WithLRU[string, int](true))
+	c.Set("A", 1, 5, 100*time.Second)
+	c.Set("B", 2, 15, 3*time.Second)
+	c.Set("C", 3, 5, 10*time.Second)
+	c.Set("D", 4, 1, 15*time.Second)
+	c.Set("E", 5, 5, 150*time.Second)
 	c.Get("C")
 
 	c.SetMaxItems(5)
@@ -64,6 +64,15 @@ func TestMain(t *testing.T) {
 	testSlice(t, c.Keys(), []string{"C"})
 }
 
+func TestDefaultIsUnbounded(t *testing.T) {
+	c := NewCache[string, int]()
+	c.Set("A", 1, 0, time.Hour)
+	c.Set("B", 2, 0, time.Hour)
+	c.Set("C", 3, 0, time.Hour)
+
+	testSlice(t, c.Keys(), []string{"A", "B", "C"})
+}
+
 func testSlice(t *testing.T, got, want []string) {
 	if !compareSlice(got, want) {
 		t.Fatalf("\ngot:  %v\nwant: %v", got, want)
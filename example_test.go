@@ -0,0 +1,40 @@
+package lru
+
+import (
+	"fmt"
+	"time"
+)
+
+// Example demonstrates the priority expiry LRU eviction behavior of
+// Cache.
+func Example() {
+	c := NewCache[string, int](WithMaxItems[string, int](5), WithLRU[string, int](true))
+	c.Set("A", 1, 5, 100*time.Second)
+	c.Set("B", 2, 15, 3*time.Second)
+	c.Set("C", 3, 5, 10*time.Second)
+	c.Set("D", 4, 1, 15*time.Second)
+	c.Set("E", 5, 5, 150*time.Second)
+	c.Get("C")
+
+	c.SetMaxItems(5)
+	fmt.Println(c.Keys())
+
+	time.Sleep(5 * time.Second)
+	c.SetMaxItems(4)
+	fmt.Println(c.Keys())
+
+	c.SetMaxItems(3)
+	fmt.Println(c.Keys())
+
+	c.SetMaxItems(2)
+	fmt.Println(c.Keys())
+
+	c.SetMaxItems(1)
+	fmt.Println(c.Keys())
+	// Output:
+	// [A B C D E]
+	// [A C D E]
+	// [A C E]
+	// [C E]
+	// [C]
+}
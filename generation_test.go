@@ -0,0 +1,45 @@
+package lru
+
+import (
+	"testing"
+	"time"
+)
+
+func TestInvalidate(t *testing.T) {
+	c := NewCache[string, int](WithMaxItems[string, int](5))
+	c.Set("A", 1, 0, time.Hour)
+	c.Set("B", 2, 0, time.Hour)
+
+	c.Invalidate()
+
+	if got := c.Len(); got != 0 {
+		t.Fatalf("Len() = %d after Invalidate, want 0", got)
+	}
+	if _, ok := c.Get("A"); ok {
+		t.Fatal("Get(\"A\") found a value after Invalidate")
+	}
+	testSlice(t, c.Keys(), nil)
+
+	// A stale entry occupying "A"'s slot must not block a fresh Set.
+	c.Set("A", 3, 0, time.Hour)
+	if got := c.Len(); got != 1 {
+		t.Fatalf("Len() = %d after Set following Invalidate, want 1", got)
+	}
+	v, ok := c.Get("A")
+	if !ok || v != 3 {
+		t.Fatalf("Get(\"A\") = %d, %v, want 3, true", v, ok)
+	}
+}
+
+func BenchmarkInvalidate(b *testing.B) {
+	const n = 1_000_000
+	c := NewCache[int, int](WithMaxItems[int, int](n))
+	for i := 0; i < n; i++ {
+		c.Set(i, i, 0, time.Hour)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		c.Invalidate()
+	}
+}
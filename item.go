@@ -0,0 +1,21 @@
+package lru
+
+import "time"
+
+// Item holds the cached value and its bookkeeping metadata.
+type Item[K comparable, V any] struct {
+	key      K
+	value    V
+	priority int
+	access   time.Time
+	expire   time.Time
+
+	// gen ties the item to the cache generation it was inserted
+	// under. Invalidate bumps the cache's current generation instead
+	// of walking every item; an item whose gen no longer matches is
+	// treated as already gone and is cleaned up lazily.
+	gen int64
+
+	priorityIndex int
+	expiryIndex   int
+}
@@ -0,0 +1,78 @@
+package lru
+
+import (
+	"runtime"
+	"time"
+)
+
+// Cache is a thread-safe, generic priority expiry LRU cache.
+//
+// Cache only wraps a *core: when WithPurgeInterval is set, the
+// background janitor goroutine holds the core, not the Cache, so it
+// never keeps the Cache itself alive. A finalizer on Cache signals the
+// janitor to stop once the last exported reference is collected.
+type Cache[K comparable, V any] struct {
+	*core[K, V]
+}
+
+func newCache[K comparable, V any](core *core[K, V]) *Cache[K, V] {
+	c := &Cache[K, V]{core: core}
+	if core.purgeInterval > 0 {
+		core.done = make(chan struct{})
+		core.stopped = make(chan struct{})
+		go core.runJanitor()
+		runtime.SetFinalizer(c, func(c *Cache[K, V]) {
+			c.Close()
+		})
+	}
+	return c
+}
+
+// Close stops the background janitor, if any. It's safe to call more
+// than once, and safe to call on a Cache with no janitor running.
+func (c *Cache[K, V]) Close() {
+	if c.done == nil {
+		return
+	}
+	c.closeOnce.Do(func() {
+		close(c.done)
+	})
+}
+
+// runJanitor periodically evicts expired items until done is closed.
+//
+// It runs on *core, not *Cache, so it never pins the exported Cache in
+// memory: the only way to stop it is through the done channel, which
+// Close (called directly, or by Cache's finalizer) closes.
+func (c *core[K, V]) runJanitor() {
+	ticker := time.NewTicker(c.purgeInterval)
+	defer ticker.Stop()
+	defer close(c.stopped)
+	for {
+		select {
+		case <-ticker.C:
+			c.purgeExpired()
+		case <-c.done:
+			return
+		}
+	}
+}
+
+// purgeExpired evicts every currently expired item, regardless of
+// maxItems.
+func (c *core[K, V]) purgeExpired() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for c.expiryQ.Len() > 0 {
+		item := c.expiryQ[0]
+		if item.gen != c.currentGeneration {
+			c.removeItem(item)
+			continue
+		}
+		if item.expire.After(time.Now()) {
+			break
+		}
+		c.removeItem(item)
+		c.fireEvicted(item, ReasonExpired)
+	}
+}
@@ -0,0 +1,21 @@
+package lru
+
+import (
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestJanitorStopsWhenCacheIsCollected(t *testing.T) {
+	c := NewCache[string, int](WithPurgeInterval[string, int](10 * time.Millisecond))
+	stopped := c.stopped
+
+	c = nil
+	runtime.GC()
+
+	select {
+	case <-stopped:
+	case <-time.After(2 * time.Second):
+		t.Fatal("janitor goroutine did not stop after the Cache was collected")
+	}
+}
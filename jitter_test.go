@@ -0,0 +1,21 @@
+package lru
+
+import (
+	"testing"
+	"time"
+)
+
+func TestExpiryJitter(t *testing.T) {
+	c := NewCache[string, int](
+		WithMaxItems[string, int](1),
+		WithDefaultTTL[string, int](100*time.Millisecond),
+		WithExpiryJitter[string, int](0.5),
+	)
+	before := time.Now()
+	c.Set("A", 1, 0, 0)
+
+	ttl := c.table["A"].expire.Sub(before)
+	if ttl < 50*time.Millisecond || ttl > 150*time.Millisecond {
+		t.Fatalf("jittered ttl %v outside the expected [50ms, 150ms] range", ttl)
+	}
+}
@@ -0,0 +1,85 @@
+package lru
+
+import "time"
+
+// EvictReason describes why an item left the cache.
+type EvictReason int
+
+const (
+	// ReasonExpired means the item's TTL had already elapsed.
+	ReasonExpired EvictReason = iota
+	// ReasonCapacity means the item was evicted to make room under
+	// MaxItems and was picked via the LRU/LRC access-time tie-break.
+	ReasonCapacity
+	// ReasonPriority means the item was evicted to make room under
+	// MaxItems and was picked for holding the lowest priority.
+	ReasonPriority
+)
+
+func (r EvictReason) String() string {
+	switch r {
+	case ReasonExpired:
+		return "expired"
+	case ReasonCapacity:
+		return "capacity"
+	case ReasonPriority:
+		return "priority"
+	default:
+		return "unknown"
+	}
+}
+
+// Option configures a Cache at construction time.
+type Option[K comparable, V any] func(*core[K, V])
+
+// WithMaxItems sets the maximum number of items the cache holds before
+// it starts evicting. Without it, or with n <= 0, the cache is
+// unbounded.
+func WithMaxItems[K comparable, V any](n int) Option[K, V] {
+	return func(c *core[K, V]) {
+		c.maxItems = n
+	}
+}
+
+// WithDefaultTTL sets the TTL applied to an item set with a zero ttl.
+func WithDefaultTTL[K comparable, V any](d time.Duration) Option[K, V] {
+	return func(c *core[K, V]) {
+		c.defaultTTL = d
+	}
+}
+
+// WithOnEvicted registers a callback invoked whenever an item leaves the
+// cache, along with the reason it left.
+func WithOnEvicted[K comparable, V any](fn func(K, V, EvictReason)) Option[K, V] {
+	return func(c *core[K, V]) {
+		c.onEvicted = fn
+	}
+}
+
+// WithLRU selects whether Get refreshes an item's access time, making the
+// cache LRU, or leaves it untouched, making the cache LRC (eviction order
+// follows insertion/update time only).
+func WithLRU[K comparable, V any](lru bool) Option[K, V] {
+	return func(c *core[K, V]) {
+		c.lru = lru
+	}
+}
+
+// WithPurgeInterval starts a background janitor goroutine that evicts
+// expired items every d, instead of relying solely on Get/Set to notice
+// them. Close stops the janitor.
+func WithPurgeInterval[K comparable, V any](d time.Duration) Option[K, V] {
+	return func(c *core[K, V]) {
+		c.purgeInterval = d
+	}
+}
+
+// WithExpiryJitter spreads out the expire time of items set together by
+// a uniform random factor in [1-fraction, 1+fraction], so a batch
+// written at once doesn't all expire in the same instant. fraction is
+// clamped to [0, 1]. The default, 0, applies no jitter.
+func WithExpiryJitter[K comparable, V any](fraction float64) Option[K, V] {
+	return func(c *core[K, V]) {
+		c.expiryJitter = fraction
+	}
+}
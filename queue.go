@@ -0,0 +1,66 @@
+package lru
+
+// https://pkg.go.dev/container/heap#example-package-PriorityQueue
+
+// ExpiryQueue orders items by their expire time, oldest first.
+type ExpiryQueue[K comparable, V any] []*Item[K, V]
+
+func (pq ExpiryQueue[K, V]) Len() int { return len(pq) }
+func (pq ExpiryQueue[K, V]) Less(i, j int) bool {
+	// To pop the oldest expire time item first.
+	return pq[i].expire.Before(pq[j].expire)
+}
+func (pq ExpiryQueue[K, V]) Swap(i, j int) {
+	pq[i], pq[j] = pq[j], pq[i]
+	pq[i].expiryIndex = i
+	pq[j].expiryIndex = j
+}
+func (pq *ExpiryQueue[K, V]) Push(x any) {
+	n := len(*pq)
+	item := x.(*Item[K, V])
+	item.expiryIndex = n
+	*pq = append(*pq, item)
+}
+func (pq *ExpiryQueue[K, V]) Pop() any {
+	old := *pq
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	item.expiryIndex = -1
+	*pq = old[0 : n-1]
+	return item
+}
+
+// PriorityQueue orders items by priority, breaking ties with the LRU
+// access time.
+type PriorityQueue[K comparable, V any] []*Item[K, V]
+
+func (pq PriorityQueue[K, V]) Len() int { return len(pq) }
+func (pq PriorityQueue[K, V]) Less(i, j int) bool {
+	// To pop the lowest priority item first.
+	if pq[i].priority == pq[j].priority {
+		// Pick the LRU item.
+		return pq[i].access.Before(pq[j].access)
+	}
+	return pq[i].priority < pq[j].priority
+}
+func (pq PriorityQueue[K, V]) Swap(i, j int) {
+	pq[i], pq[j] = pq[j], pq[i]
+	pq[i].priorityIndex = i
+	pq[j].priorityIndex = j
+}
+func (pq *PriorityQueue[K, V]) Push(x any) {
+	n := len(*pq)
+	item := x.(*Item[K, V])
+	item.priorityIndex = n
+	*pq = append(*pq, item)
+}
+func (pq *PriorityQueue[K, V]) Pop() any {
+	old := *pq
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	item.priorityIndex = -1
+	*pq = old[0 : n-1]
+	return item
+}
@@ -0,0 +1,58 @@
+package lru
+
+import (
+	"sync"
+	"time"
+)
+
+// call tracks an in-flight loader invocation shared by concurrent
+// GetOrLoad callers for the same key.
+type call[V any] struct {
+	wg  sync.WaitGroup
+	val V
+	err error
+}
+
+// GetOrLoad returns the cached value for key, or invokes loader to
+// produce one on a miss or expired entry. Concurrent callers for the
+// same key share a single loader invocation: one calls loader, the rest
+// block and receive its result. On success, the result is stored via
+// Set with the given priority and ttl; on error, nothing is cached and
+// the error is returned to every waiter.
+func (c *core[K, V]) GetOrLoad(key K, priority int, ttl time.Duration, loader func() (V, error)) (V, error) {
+	if v, ok := c.Get(key); ok {
+		return v, nil
+	}
+
+	c.sfMu.Lock()
+	if cl, ok := c.calls[key]; ok {
+		c.sfMu.Unlock()
+		cl.wg.Wait()
+		return cl.val, cl.err
+	}
+	cl := new(call[V])
+	cl.wg.Add(1)
+	if c.calls == nil {
+		c.calls = make(map[K]*call[V])
+	}
+	c.calls[key] = cl
+	c.sfMu.Unlock()
+
+	// If loader panics, the deferred cleanup still removes the call
+	// entry and releases every waiter so the key isn't wedged forever;
+	// the panic itself propagates to this goroutine's caller, same as
+	// golang.org/x/sync/singleflight.
+	defer func() {
+		c.sfMu.Lock()
+		delete(c.calls, key)
+		c.sfMu.Unlock()
+		cl.wg.Done()
+	}()
+
+	cl.val, cl.err = loader()
+	if cl.err == nil {
+		c.Set(key, cl.val, priority, ttl)
+	}
+
+	return cl.val, cl.err
+}
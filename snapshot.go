@@ -0,0 +1,91 @@
+package lru
+
+import (
+	"container/heap"
+	"encoding/gob"
+	"io"
+	"time"
+)
+
+// snapshotItem is the on-the-wire representation of an Item. Times are
+// stored relative to the moment of the snapshot, not as absolute
+// values, so a cache restored later reconstructs expiry and access
+// times relative to the new time.Now().
+type snapshotItem[K comparable, V any] struct {
+	Key      K
+	Value    V
+	Priority int
+	// TTL is the time remaining until expiry when the snapshot was
+	// taken.
+	TTL time.Duration
+	// SinceAccess is how long before the snapshot the item was last
+	// accessed.
+	SinceAccess time.Duration
+}
+
+// SaveTo serializes the cache's current items to w via encoding/gob,
+// for later recovery with LoadFrom. Expired items and any left behind
+// by a prior Invalidate/Purge are skipped.
+func (c *core[K, V]) SaveTo(w io.Writer) error {
+	c.mu.Lock()
+	now := time.Now()
+	items := make([]snapshotItem[K, V], 0, c.logicalLen)
+	for _, item := range c.table {
+		if item.gen != c.currentGeneration || !item.expire.After(now) {
+			continue
+		}
+		items = append(items, snapshotItem[K, V]{
+			Key:         item.key,
+			Value:       item.value,
+			Priority:    item.priority,
+			TTL:         item.expire.Sub(now),
+			SinceAccess: now.Sub(item.access),
+		})
+	}
+	c.mu.Unlock()
+	return gob.NewEncoder(w).Encode(items)
+}
+
+// LoadFrom replaces the cache's contents with the snapshot read from r,
+// written by a prior SaveTo. Expiry and access times are reconstructed
+// relative to time.Now(), and both heaps are rebuilt with a single
+// heap.Init rather than per-item Push, for O(n) restoration. maxItems
+// is re-applied once loading completes, in case it's lower than when
+// the snapshot was taken.
+func (c *core[K, V]) LoadFrom(r io.Reader) error {
+	var items []snapshotItem[K, V]
+	if err := gob.NewDecoder(r).Decode(&items); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	c.currentGeneration++
+	c.table = make(map[K]*Item[K, V], len(items))
+	c.priorityQ = make(PriorityQueue[K, V], 0, len(items))
+	c.expiryQ = make(ExpiryQueue[K, V], 0, len(items))
+
+	for _, si := range items {
+		item := &Item[K, V]{
+			key:           si.Key,
+			value:         si.Value,
+			priority:      si.Priority,
+			expire:        now.Add(si.TTL),
+			access:        now.Add(-si.SinceAccess),
+			gen:           c.currentGeneration,
+			priorityIndex: len(c.priorityQ),
+			expiryIndex:   len(c.expiryQ),
+		}
+		c.table[si.Key] = item
+		c.priorityQ = append(c.priorityQ, item)
+		c.expiryQ = append(c.expiryQ, item)
+	}
+	heap.Init(&c.priorityQ)
+	heap.Init(&c.expiryQ)
+	c.logicalLen = len(items)
+
+	c.evictItems()
+	return nil
+}
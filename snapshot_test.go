@@ -0,0 +1,73 @@
+package lru
+
+import (
+	"bytes"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	const n = 10_000
+	src := NewCache[int, int](WithMaxItems[int, int](n), WithLRU[int, int](true))
+	for i := 0; i < n; i++ {
+		src.Set(i, i, i%5, time.Hour)
+	}
+	// Vary access order so the LRU tie-break has something to preserve.
+	for i := 0; i < n; i += 2 {
+		src.Get(i)
+	}
+
+	var buf bytes.Buffer
+	if err := src.SaveTo(&buf); err != nil {
+		t.Fatalf("SaveTo: %v", err)
+	}
+
+	dst := NewCache[int, int](WithMaxItems[int, int](n), WithLRU[int, int](true))
+	if err := dst.LoadFrom(&buf); err != nil {
+		t.Fatalf("LoadFrom: %v", err)
+	}
+	if got, want := dst.Len(), src.Len(); got != want {
+		t.Fatalf("Len() = %d, want %d", got, want)
+	}
+
+	// Shrinking both caches the same way must evict the same keys in
+	// the same order, proving the restored heaps preserved priority
+	// and LRU ordering.
+	src.SetMaxItems(10)
+	dst.SetMaxItems(10)
+	testIntSlice(t, dst.Keys(), src.Keys())
+}
+
+func TestSaveToSkipsExpiredItems(t *testing.T) {
+	c := NewCache[string, int](WithMaxItems[string, int](5))
+	c.Set("A", 1, 0, time.Hour)
+	c.Set("B", 2, 0, time.Nanosecond)
+	time.Sleep(time.Millisecond)
+
+	var buf bytes.Buffer
+	if err := c.SaveTo(&buf); err != nil {
+		t.Fatalf("SaveTo: %v", err)
+	}
+
+	dst := NewCache[string, int](WithMaxItems[string, int](5))
+	if err := dst.LoadFrom(&buf); err != nil {
+		t.Fatalf("LoadFrom: %v", err)
+	}
+	testSlice(t, dst.Keys(), []string{"A"})
+}
+
+func testIntSlice(t *testing.T, got, want []int) {
+	g := append([]int(nil), got...)
+	w := append([]int(nil), want...)
+	sort.Ints(g)
+	sort.Ints(w)
+	if len(g) != len(w) {
+		t.Fatalf("\ngot:  %v\nwant: %v", g, w)
+	}
+	for i := range g {
+		if g[i] != w[i] {
+			t.Fatalf("\ngot:  %v\nwant: %v", g, w)
+		}
+	}
+}
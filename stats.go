@@ -0,0 +1,35 @@
+package lru
+
+// Stats is a snapshot of a Cache's hit/miss and eviction counters.
+type Stats struct {
+	Hits   uint64
+	Misses uint64
+	Added  uint64
+
+	// Evicted is the total of Expired, Capacity and Priority.
+	Evicted  uint64
+	Expired  uint64
+	Capacity uint64
+	Priority uint64
+
+	Size int
+}
+
+// Stats returns a snapshot of the cache's hit/miss and eviction
+// counters. The counters are atomic, so callers don't need to hold the
+// cache lock to read them.
+func (c *core[K, V]) Stats() Stats {
+	expired := c.statExpired.Load()
+	capacity := c.statCapacity.Load()
+	priority := c.statPriority.Load()
+	return Stats{
+		Hits:     c.statHits.Load(),
+		Misses:   c.statMisses.Load(),
+		Added:    c.statAdded.Load(),
+		Evicted:  expired + capacity + priority,
+		Expired:  expired,
+		Capacity: capacity,
+		Priority: priority,
+		Size:     c.Len(),
+	}
+}
@@ -0,0 +1,80 @@
+package lru
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStats(t *testing.T) {
+	c := NewCache[string, int](WithMaxItems[string, int](2))
+	c.Set("A", 1, 0, time.Hour)
+	c.Set("B", 2, 0, time.Hour)
+	c.Set("C", 3, 0, time.Hour) // evicts one of A/B on capacity.
+
+	c.Get("C")
+	c.Get("missing")
+
+	stats := c.Stats()
+	if stats.Added != 3 {
+		t.Errorf("Added = %d, want 3", stats.Added)
+	}
+	if stats.Hits != 1 {
+		t.Errorf("Hits = %d, want 1", stats.Hits)
+	}
+	if stats.Misses != 1 {
+		t.Errorf("Misses = %d, want 1", stats.Misses)
+	}
+	if stats.Evicted != 1 {
+		t.Errorf("Evicted = %d, want 1", stats.Evicted)
+	}
+	if stats.Size != 2 {
+		t.Errorf("Size = %d, want 2", stats.Size)
+	}
+}
+
+func TestEvictReason(t *testing.T) {
+	var reasons []EvictReason
+	c := NewCache[string, int](
+		WithMaxItems[string, int](2),
+		WithOnEvicted[string, int](func(_ string, _ int, reason EvictReason) {
+			reasons = append(reasons, reason)
+		}),
+	)
+
+	// "A" and "B" share priority 0: the third Set evicts "A" on the LRU
+	// tie-break, but "B" remains at that same priority, so the reason is
+	// ReasonCapacity, not ReasonPriority.
+	c.Set("A", 1, 0, time.Hour)
+	c.Set("B", 2, 0, time.Hour)
+	c.Set("C", 3, 0, time.Hour)
+	if len(reasons) != 1 || reasons[0] != ReasonCapacity {
+		t.Fatalf("reasons = %v, want [%v]", reasons, ReasonCapacity)
+	}
+
+	// "D" uniquely holds the lowest priority of the three, so it's
+	// evicted immediately on its own Set for ReasonPriority.
+	reasons = nil
+	c.Set("D", 4, -1, time.Hour)
+	if len(reasons) != 1 || reasons[0] != ReasonPriority {
+		t.Fatalf("reasons = %v, want [%v]", reasons, ReasonPriority)
+	}
+
+	// The remaining two items again share priority 0, so the next Set
+	// evicts on the LRU tie-break, for ReasonCapacity.
+	reasons = nil
+	c.Set("E", 5, 0, time.Hour)
+	if len(reasons) != 1 || reasons[0] != ReasonCapacity {
+		t.Fatalf("reasons = %v, want [%v]", reasons, ReasonCapacity)
+	}
+}
+
+func TestInvalidateFn(t *testing.T) {
+	c := NewCache[string, int](WithMaxItems[string, int](5))
+	c.Set("A", 1, 0, time.Hour)
+	c.Set("B", 2, 0, time.Hour)
+	c.Set("C", 3, 0, time.Hour)
+
+	c.InvalidateFn(func(key string) bool { return key != "B" })
+
+	testSlice(t, c.Keys(), []string{"B"})
+}